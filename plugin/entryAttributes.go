@@ -48,18 +48,26 @@ to do something like
 	attr.
 		SetCtime(ctime).
 		SetMtime(mtime).
-		SetMeta(meta)
+		SetSystemMeta(meta)
 	entry.SetAttributes(attr)
+
+NOTE: EntryAttributes carries only system metadata (SystemMeta, which
+replaces the old Meta) plus an optional MetadataInfo schema for it. It has
+no user-metadata slot, and wash has no command, plugin capability, API
+endpoint or store that sets, unsets or persists user metadata or serves
+the schema.
 */
 type EntryAttributes struct {
-	atime   time.Time
-	mtime   time.Time
-	ctime   time.Time
-	mode    os.FileMode
-	hasMode bool
-	size    uint64
-	hasSize bool
-	meta    JSONObject
+	atime      time.Time
+	mtime      time.Time
+	ctime      time.Time
+	btime      time.Time
+	mode       os.FileMode
+	hasMode    bool
+	size       uint64
+	hasSize    bool
+	systemMeta JSONObject
+	schema     *MetadataInfo
 }
 
 // We can't just export EntryAttributes' fields because there's no way
@@ -128,6 +136,31 @@ func (a *EntryAttributes) SetCtime(ctime time.Time) *EntryAttributes {
 	return a
 }
 
+// HasBtime returns true if the entry has a birth time
+func (a *EntryAttributes) HasBtime() bool {
+	return !a.btime.IsZero()
+}
+
+// Btime returns the entry's birth (creation) time. It's distinct from
+// Ctime, which on Unix systems means "inode last changed" rather than
+// "created" -- plugins that can report an actual creation time (e.g. an
+// S3 object's LastModified-at-creation, a Docker image's Created, or a
+// Kubernetes resource's metadata.creationTimestamp) should use Btime
+// instead of overloading Ctime with it.
+//
+// NOTE: Btime is only wired through ToMap and the JSON codec so far.
+// There's no find-expression package in this tree yet to add a
+// `wash find -btime` predicate to; that's left for whoever adds it.
+func (a *EntryAttributes) Btime() time.Time {
+	return a.btime
+}
+
+// SetBtime sets the entry's birth time
+func (a *EntryAttributes) SetBtime(btime time.Time) *EntryAttributes {
+	a.btime = btime
+	return a
+}
+
 // HasMode returns true if the entry has a mode
 func (a *EntryAttributes) HasMode() bool {
 	return a.hasMode
@@ -162,30 +195,48 @@ func (a *EntryAttributes) SetSize(size uint64) *EntryAttributes {
 	return a
 }
 
-// Meta returns the entry's meta attribute. If a.SetMeta(obj) was called,
-// then this returns obj serialized to JSONObject. Otherwise, it returns
-// a.ToMap(false).
+// SystemMeta returns the entry's system metadata, i.e. the metadata that's
+// owned by the plugin/source and that wash users can't modify. If
+// a.SetSystemMeta(obj) was called, then this returns obj serialized to
+// JSONObject. Otherwise, it returns a.ToMap(false).
 //
-// NOTE: The meta attribute is a subset of the entry's full metadata, which
-// is what e.Metadata returns. It is typically provided by the plugin API's
-// List endpoint.
-func (a *EntryAttributes) Meta() JSONObject {
-	if a.meta == nil {
+// NOTE: The system meta attribute is a subset of the entry's full metadata,
+// which is what e.Metadata returns. It is typically provided by the plugin
+// API's List endpoint.
+func (a *EntryAttributes) SystemMeta() JSONObject {
+	if a.systemMeta == nil {
 		return a.ToMap(false)
 	}
 
-	return a.meta
+	return a.systemMeta
 }
 
-// SetMeta sets the entry's meta attribute to obj. This is typically the
-// raw object that's returned by the plugin API's List endpoint, or a wrapper
-// that includes the raw object + some additional information. For example, if
-// the entry represents a Docker container, then obj would be a Container struct.
-// If the entry represents a Docker volume, then obj would be a Volume struct.
+// SetSystemMeta sets the entry's system meta attribute to obj. This is
+// typically the raw object that's returned by the plugin API's List
+// endpoint, or a wrapper that includes the raw object + some additional
+// information. For example, if the entry represents a Docker container,
+// then obj would be a Container struct. If the entry represents a Docker
+// volume, then obj would be a Volume struct.
 //
-// SetMeta will panic if obj does not serialize to a JSON object.
-func (a *EntryAttributes) SetMeta(obj interface{}) *EntryAttributes {
-	a.meta = ToJSONObject(obj)
+// SetSystemMeta will panic if obj does not serialize to a JSON object.
+func (a *EntryAttributes) SetSystemMeta(obj interface{}) *EntryAttributes {
+	a.systemMeta = ToJSONObject(obj)
+	return a
+}
+
+// Schema returns the schema the plugin declared for this entry's system
+// metadata, or nil if it didn't declare one.
+func (a *EntryAttributes) Schema() *MetadataInfo {
+	return a.schema
+}
+
+// SetSchema declares the shape of the entry's system metadata: which keys
+// to expect and what they mean. It's optional -- undeclared keys are still
+// passed through as opaque JSON. ToMap and the JSON codec carry a declared
+// schema under the "meta_schema" key; nothing in wash validates metadata
+// or `-meta` expressions against it.
+func (a *EntryAttributes) SetSchema(schema *MetadataInfo) *EntryAttributes {
+	a.schema = schema
 	return a
 }
 
@@ -202,6 +253,9 @@ func (a *EntryAttributes) ToMap(includeMeta bool) map[string]interface{} {
 	if a.HasCtime() {
 		mp["ctime"] = a.Ctime()
 	}
+	if a.HasBtime() {
+		mp["btime"] = a.Btime()
+	}
 	if a.HasMode() {
 		// The mode string representation is the only portable representation. FileMode uses its own
 		// definitions for type bits, not those in http://man7.org/linux/man-pages/man7/inode.7.html.
@@ -211,7 +265,10 @@ func (a *EntryAttributes) ToMap(includeMeta bool) map[string]interface{} {
 		mp["size"] = a.Size()
 	}
 	if includeMeta {
-		mp["meta"] = a.Meta()
+		mp["meta"] = a.SystemMeta()
+		if a.schema != nil {
+			mp["meta_schema"] = a.schema
+		}
 	}
 	return mp
 }
@@ -226,6 +283,21 @@ func (a EntryAttributes) MarshalJSON() ([]byte, error) {
 	if a.HasMode() {
 		m["mode"] = a.Mode()
 	}
+	// Override the times to wash's byte-stable RFC3339 string form. ToMap
+	// itself keeps raw time.Time values, so times inside the "meta" object
+	// (SystemMeta's ToMap fallback) keep time.Time's default JSON form.
+	if a.HasAtime() {
+		m["atime"] = marshalTime(a.Atime())
+	}
+	if a.HasMtime() {
+		m["mtime"] = marshalTime(a.Mtime())
+	}
+	if a.HasCtime() {
+		m["ctime"] = marshalTime(a.Ctime())
+	}
+	if a.HasBtime() {
+		m["btime"] = marshalTime(a.Btime())
+	}
 	return json.Marshal(m)
 }
 
@@ -237,26 +309,33 @@ func (a *EntryAttributes) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("plugin.EntryAttributes.UnmarshalJSON received a non-JSON object")
 	}
 	if atime, ok := mp["atime"]; ok {
-		t, err := munge.ToTime(atime)
+		t, err := unmarshalTime(atime)
 		if err != nil {
 			return attrMungeError("atime", err)
 		}
 		a.SetAtime(t)
 	}
 	if mtime, ok := mp["mtime"]; ok {
-		t, err := munge.ToTime(mtime)
+		t, err := unmarshalTime(mtime)
 		if err != nil {
 			return attrMungeError("mtime", err)
 		}
 		a.SetMtime(t)
 	}
 	if ctime, ok := mp["ctime"]; ok {
-		t, err := munge.ToTime(ctime)
+		t, err := unmarshalTime(ctime)
 		if err != nil {
 			return attrMungeError("ctime", err)
 		}
 		a.SetCtime(t)
 	}
+	if btime, ok := mp["btime"]; ok {
+		t, err := unmarshalTime(btime)
+		if err != nil {
+			return attrMungeError("btime", err)
+		}
+		a.SetBtime(t)
+	}
 	if mode, ok := mp["mode"]; ok {
 		// Even though os.FileModes are uint32 types, json.Unmarshal unmarshals them as float64.
 		// That's ok, because float64 has sufficient precision to represent all uint32 types.
@@ -278,7 +357,20 @@ func (a *EntryAttributes) UnmarshalJSON(data []byte) error {
 		if !isObj {
 			return fmt.Errorf("meta is not a JSON object")
 		}
-		a.SetMeta(meta)
+		a.SetSystemMeta(meta)
+	}
+	if rawSchema, ok := mp["meta_schema"]; ok {
+		// rawSchema unmarshaled as a generic map[string]interface{}; round
+		// it through JSON again to decode it into a MetadataInfo.
+		data, err := json.Marshal(rawSchema)
+		if err != nil {
+			return attrMungeError("meta_schema", err)
+		}
+		var schema MetadataInfo
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return attrMungeError("meta_schema", err)
+		}
+		a.SetSchema(&schema)
 	}
 	return nil
 }
@@ -286,3 +378,52 @@ func (a *EntryAttributes) UnmarshalJSON(data []byte) error {
 func attrMungeError(name string, err error) error {
 	return fmt.Errorf("plugin.EntryAttributes.UnmarshalJSON: could not munge the %v attribute: %v", name, err)
 }
+
+// marshalTime formats the time attributes that MarshalJSON writes (atime,
+// mtime, ctime, btime) -- RFC3339 in UTC, with the nanosecond component
+// included only when it's non-zero -- so that those values round-trip
+// byte-for-byte through the wash API and journal instead of picking up
+// spurious precision.
+func marshalTime(t time.Time) string {
+	t = t.UTC()
+	if t.Nanosecond() == 0 {
+		return t.Format(time.RFC3339)
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// unmarshalTime parses v into a time.Time. A nil or empty value unmarshals
+// to the zero time (leaving the corresponding Has*time false). Otherwise it
+// tries, in order: RFC3339Nano, RFC3339, unix seconds/milliseconds (for
+// numeric input), and finally munge.ToTime's looser heuristics.
+func unmarshalTime(v interface{}) (time.Time, error) {
+	if v == nil {
+		return time.Time{}, nil
+	}
+	if s, ok := v.(string); ok {
+		if s == "" {
+			return time.Time{}, nil
+		}
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t, nil
+		}
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t, nil
+		}
+	}
+	if n, ok := v.(float64); ok {
+		// Unix seconds for any plausible entry time (even a generous
+		// future one) stay below 1e11; unix milliseconds cross it for
+		// any date from 1973 onward. That asymmetry -- not digit count --
+		// is what the boundary picks out, so it only misclassifies
+		// millisecond timestamps from before 1973, which no real entry
+		// should have.
+		if n > 1e11 {
+			return time.Unix(0, int64(n*float64(time.Millisecond))).UTC(), nil
+		}
+		sec := int64(n)
+		nsec := int64((n - float64(sec)) * float64(time.Second))
+		return time.Unix(sec, nsec).UTC(), nil
+	}
+	return munge.ToTime(v)
+}
@@ -0,0 +1,100 @@
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeTestSuite) TestTime_Roundtrip() {
+	canonical := time.Date(2020, time.June, 15, 12, 30, 0, 0, time.UTC)
+	canonicalNanos := time.Date(2020, time.June, 15, 12, 30, 0, 123000000, time.UTC)
+	post1973 := time.Date(1995, time.January, 1, 0, 0, 0, 0, time.UTC)
+	pre1973 := time.Date(1970, time.June, 1, 0, 0, 0, 0, time.UTC)
+	fractional := time.Date(2020, time.June, 15, 12, 30, 0, 500000000, time.UTC)
+
+	pre1973Millis := float64(pre1973.UnixNano() / int64(time.Millisecond))
+
+	cases := []struct {
+		name     string
+		input    interface{}
+		expected time.Time
+	}{
+		{"nil", nil, time.Time{}},
+		{"empty string", "", time.Time{}},
+		{"RFC3339Nano", "2020-06-15T12:30:00.123Z", canonicalNanos},
+		{"RFC3339", "2020-06-15T12:30:00Z", canonical},
+		{"unix seconds", float64(canonical.Unix()), canonical},
+		{"unix seconds with fraction", float64(canonical.Unix()) + 0.5, fractional},
+		{"unix millis (post-1973)", float64(canonical.UnixNano() / int64(time.Millisecond)), canonical},
+		{"unix millis (post-1973, 1995)", float64(post1973.UnixNano() / int64(time.Millisecond)), post1973},
+		// unmarshalTime's seconds/millis boundary is documented to
+		// misclassify millisecond timestamps before 1973 as seconds; this
+		// pins down that known behavior rather than silently relying on it.
+		{"unix millis before 1973 (documented misclassification)", pre1973Millis, time.Unix(int64(pre1973Millis), 0).UTC()},
+	}
+
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			parsed, err := unmarshalTime(c.input)
+			suite.NoError(err)
+			suite.True(c.expected.Equal(parsed), "expected %v, got %v", c.expected, parsed)
+		})
+	}
+
+	suite.Run("marshal strips zero nanoseconds", func() {
+		suite.Equal("2020-06-15T12:30:00Z", marshalTime(canonical))
+	})
+
+	suite.Run("marshal keeps non-zero nanoseconds", func() {
+		suite.Equal("2020-06-15T12:30:00.123Z", marshalTime(canonicalNanos))
+	})
+
+	suite.Run("marshal then unmarshal is stable", func() {
+		marshaled := marshalTime(canonical)
+		parsed, err := unmarshalTime(marshaled)
+		suite.NoError(err)
+		suite.True(canonical.Equal(parsed))
+		suite.Equal(marshaled, marshalTime(parsed))
+	})
+}
+
+func TestTime(t *testing.T) {
+	suite.Run(t, new(TimeTestSuite))
+}
+
+type MetadataInfoTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MetadataInfoTestSuite) TestMetadataInfo_Roundtrip() {
+	schema := &MetadataInfo{
+		Attributes: map[string]MetaAttributeSchema{
+			"status": {Type: "string", Help: "the container's status", Scope: SystemMetaAttribute},
+		},
+	}
+
+	attr := EntryAttributes{}
+	attr.SetSystemMeta(JSONObject{"status": "running"}).SetSchema(schema)
+
+	data, err := attr.MarshalJSON()
+	suite.NoError(err)
+
+	var roundtripped EntryAttributes
+	suite.NoError(roundtripped.UnmarshalJSON(data))
+	suite.Equal(schema.Attributes, roundtripped.Schema().Attributes)
+}
+
+func (suite *MetadataInfoTestSuite) TestMetadataInfo_NilByDefault() {
+	attr := EntryAttributes{}
+	suite.Nil(attr.Schema())
+}
+
+func TestMetadataInfo(t *testing.T) {
+	suite.Run(t, new(MetadataInfoTestSuite))
+}
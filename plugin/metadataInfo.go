@@ -0,0 +1,40 @@
+package plugin
+
+// MetaAttributeScope describes who owns a metadata key: the plugin/source
+// itself, or a wash user annotating the entry.
+type MetaAttributeScope int
+
+const (
+	// SystemMetaAttribute marks a key that comes from the underlying
+	// resource and can't be changed through wash, e.g. a Docker
+	// container's Status or an EC2 instance's InstanceType.
+	SystemMetaAttribute MetaAttributeScope = iota
+	// UserMetaAttribute marks a key as free-form user metadata. It only
+	// labels the key in a MetadataInfo: EntryAttributes has no
+	// user-metadata slot and wash has nothing that sets such keys.
+	UserMetaAttribute
+)
+
+// MetaAttributeSchema documents a single metadata key: its expected type,
+// what it means and who owns it. Nothing in wash validates metadata values
+// or `-meta` expressions against it.
+type MetaAttributeSchema struct {
+	// Type is the key's expected JSON type, e.g. "string", "number",
+	// "boolean", "array", "object".
+	Type string `json:"type"`
+	// Help is a short, human-readable description of what the key means.
+	Help string `json:"help"`
+	// Scope is whether the key is system metadata (SystemMeta) or user
+	// metadata (see UserMetaAttribute).
+	Scope MetaAttributeScope `json:"scope"`
+}
+
+// MetadataInfo describes the shape of the metadata a plugin's entries
+// expose. A plugin declares one via EntryAttributes.SetSchema.
+//
+// MetadataInfo is intentionally sparse: undocumented keys are still
+// accepted and passed through as opaque JSON. No key, documented or not,
+// is validated against it.
+type MetadataInfo struct {
+	Attributes map[string]MetaAttributeSchema `json:"attributes"`
+}